@@ -0,0 +1,91 @@
+// Package calc implements the /v1/calc/* calculator endpoints.
+package calc
+
+import (
+	"net/http"
+
+	"github.com/go-chi/chi"
+
+	"github.com/AnimeshRy/goStuff/internal/httpx"
+)
+
+type CalculationRequest struct {
+	A int `json:"a"`
+	B int `json:"b"`
+}
+
+type CalculationResponse struct {
+	Result int `json:"result"`
+}
+
+// Routes registers the calculator endpoints on r.
+func Routes(r chi.Router) {
+	r.Post("/add", addHandler)
+	r.Post("/subtract", subtractHandler)
+	r.Post("/multiply", multiplyHandler)
+	r.Post("/divide", divideHandler)
+}
+
+func writeResult(w http.ResponseWriter, result int) {
+	httpx.WriteJSON(w, http.StatusOK, CalculationResponse{Result: result})
+}
+
+func addHandler(w http.ResponseWriter, r *http.Request) {
+	req, ok := httpx.Validate[CalculationRequest](w, r)
+	if !ok {
+		return
+	}
+
+	result, apiErr := intBinaryOp("add", req.A, req.B)
+	if apiErr != nil {
+		apiErr.WriteJSON(w)
+		return
+	}
+
+	writeResult(w, result)
+}
+
+func subtractHandler(w http.ResponseWriter, r *http.Request) {
+	req, ok := httpx.Validate[CalculationRequest](w, r)
+	if !ok {
+		return
+	}
+
+	result, apiErr := intBinaryOp("subtract", req.A, req.B)
+	if apiErr != nil {
+		apiErr.WriteJSON(w)
+		return
+	}
+
+	writeResult(w, result)
+}
+
+func multiplyHandler(w http.ResponseWriter, r *http.Request) {
+	req, ok := httpx.Validate[CalculationRequest](w, r)
+	if !ok {
+		return
+	}
+
+	result, apiErr := intBinaryOp("multiply", req.A, req.B)
+	if apiErr != nil {
+		apiErr.WriteJSON(w)
+		return
+	}
+
+	writeResult(w, result)
+}
+
+func divideHandler(w http.ResponseWriter, r *http.Request) {
+	req, ok := httpx.Validate[CalculationRequest](w, r)
+	if !ok {
+		return
+	}
+
+	result, apiErr := intBinaryOp("divide", req.A, req.B)
+	if apiErr != nil {
+		apiErr.WriteJSON(w)
+		return
+	}
+
+	writeResult(w, result)
+}
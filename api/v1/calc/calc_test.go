@@ -1,21 +1,34 @@
-package main
+package calc
 
 import (
 	"bytes"
 	"encoding/json"
+	"math"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+
+	"github.com/go-chi/chi"
+
+	"github.com/AnimeshRy/goStuff/internal/apierr"
+	"github.com/AnimeshRy/goStuff/internal/httpx"
 )
 
+func newTestRouter() http.Handler {
+	r := chi.NewRouter()
+	r.Use(httpx.LoggingMiddleware)
+	Routes(r)
+	return r
+}
+
 func TestCalculatorAPI(t *testing.T) {
 	tests := []struct {
-		name           string
-		endpoint       string
-		request        CalculationRequest
-		expectedStatus int
-		expectedResult *int
-		expectedError  string
+		name              string
+		endpoint          string
+		request           CalculationRequest
+		expectedStatus    int
+		expectedResult    *int
+		expectedErrorCode int
 	}{
 		{
 			name:           "Add success",
@@ -46,51 +59,40 @@ func TestCalculatorAPI(t *testing.T) {
 			expectedResult: intPtr(2),
 		},
 		{
-			name:           "Divide by zero",
-			endpoint:       "/divide",
-			request:        CalculationRequest{A: 5, B: 0},
-			expectedStatus: http.StatusBadRequest,
-			expectedError:  "Division by zero is not allowed",
+			name:              "Divide by zero",
+			endpoint:          "/divide",
+			request:           CalculationRequest{A: 5, B: 0},
+			expectedStatus:    http.StatusBadRequest,
+			expectedErrorCode: apierr.CodeDivisionByZero,
+		},
+		{
+			name:              "Multiply overflow",
+			endpoint:          "/multiply",
+			request:           CalculationRequest{A: math.MaxInt, B: 2},
+			expectedStatus:    http.StatusBadRequest,
+			expectedErrorCode: apierr.CodeOverflow,
 		},
 	}
 
+	router := newTestRouter()
+
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			// Create Request Body
 			body, err := json.Marshal(tt.request)
 			if err != nil {
 				t.Fatal(err)
 			}
 
-			// Create request
 			req := httptest.NewRequest(http.MethodPost, tt.endpoint, bytes.NewBuffer(body))
 			req.Header.Set("Content-Type", "application/json")
 
-			// Create response recorder
 			rr := httptest.NewRecorder()
+			router.ServeHTTP(rr, req)
 
-			// Create handler based on endpoint
-			var handler http.HandlerFunc
-			switch tt.endpoint {
-			case "/add":
-				handler = addHandler
-			case "/subtract":
-				handler = subtractHandler
-			case "/multiply":
-				handler = multiplyHandler
-			case "/divide":
-				handler = divideHandler
-			}
-
-			// Call handler
-			handler(rr, req)
-
-			// Check status code
 			if rr.Code != tt.expectedStatus {
 				t.Errorf("handler returned wrong status code: got %v want %v", rr.Code, tt.expectedStatus)
 			}
 
-			// Parse response
 			if tt.expectedResult != nil {
 				var response CalculationResponse
 				if err := json.NewDecoder(rr.Body).Decode(&response); err != nil {
@@ -101,13 +103,13 @@ func TestCalculatorAPI(t *testing.T) {
 				}
 			}
 
-			if tt.expectedError != "" {
-				var response ErrorResponse
+			if tt.expectedErrorCode != 0 {
+				var response apierr.Error
 				if err := json.NewDecoder(rr.Body).Decode(&response); err != nil {
 					t.Fatal(err)
 				}
-				if response.Error != tt.expectedError {
-					t.Errorf("handler returned unexpected response body: got %v want %v", response.Error, tt.expectedError)
+				if response.Code != tt.expectedErrorCode {
+					t.Errorf("handler returned unexpected error code: got %v want %v", response.Code, tt.expectedErrorCode)
 				}
 			}
 		})
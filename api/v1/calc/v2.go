@@ -0,0 +1,279 @@
+package calc
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"net/http"
+	"strconv"
+
+	"github.com/go-chi/chi"
+	"github.com/shopspring/decimal"
+
+	"github.com/AnimeshRy/goStuff/internal/apierr"
+	"github.com/AnimeshRy/goStuff/internal/httpx"
+)
+
+// Mode selects the numeric representation used by the v2 endpoint.
+type Mode string
+
+const (
+	ModeInt     Mode = "int"
+	ModeBigInt  Mode = "bigint"
+	ModeDecimal Mode = "decimal"
+)
+
+// CalculationRequestV2 carries its operands as strings so they can be
+// decoded into the arbitrary-precision type requested by Mode.
+type CalculationRequestV2 struct {
+	A         string `json:"a"`
+	B         string `json:"b"`
+	Mode      Mode   `json:"mode"`
+	Precision int    `json:"precision"`
+}
+
+// CalculationResponseV2 reports the result as a string, since it may not fit
+// in a machine word (bigint) or may carry a fractional part (decimal).
+type CalculationResponseV2 struct {
+	Result string `json:"result"`
+}
+
+// RoutesV2 registers the /v2/calc/{op} endpoint on r.
+func RoutesV2(r chi.Router) {
+	r.Post("/{op}", calculateV2Handler)
+}
+
+func calculateV2Handler(w http.ResponseWriter, r *http.Request) {
+	req, ok := httpx.Validate[CalculationRequestV2](w, r)
+	if !ok {
+		return
+	}
+
+	op := chi.URLParam(r, "op")
+
+	if req.Precision < 0 {
+		apierr.New(apierr.CodeInvalidBody, "precision must not be negative").WriteJSON(w)
+		return
+	}
+
+	switch req.Mode {
+	case ModeInt:
+		calculateInt(w, req, op)
+	case ModeBigInt:
+		calculateBigInt(w, req, op)
+	case ModeDecimal, "":
+		calculateDecimal(w, req, op)
+	default:
+		apierr.New(apierr.CodeInvalidBody, fmt.Sprintf("unsupported mode %q", req.Mode)).WriteJSON(w)
+	}
+}
+
+func calculateInt(w http.ResponseWriter, req *CalculationRequestV2, op string) {
+	a, err := strconv.Atoi(req.A)
+	if err != nil {
+		apierr.New(apierr.CodeInvalidBody, "a is not a valid integer").WriteJSON(w)
+		return
+	}
+
+	var b int
+	if op != "sqrt" {
+		b, err = strconv.Atoi(req.B)
+		if err != nil {
+			apierr.New(apierr.CodeInvalidBody, "b is not a valid integer").WriteJSON(w)
+			return
+		}
+	}
+
+	var result int
+	switch op {
+	case "add", "subtract", "multiply", "divide":
+		r, apiErr := intBinaryOp(op, a, b)
+		if apiErr != nil {
+			apiErr.WriteJSON(w)
+			return
+		}
+		result = r
+	case "mod":
+		if b == 0 {
+			apierr.New(apierr.CodeDivisionByZero, "").WriteJSON(w)
+			return
+		}
+		result = a % b
+	case "power":
+		if b < 0 {
+			apierr.New(apierr.CodeInvalidBody, "b must not be negative for int power").WriteJSON(w)
+			return
+		}
+		bigResult := new(big.Int).Exp(big.NewInt(int64(a)), big.NewInt(int64(b)), nil)
+		if !bigResult.IsInt64() {
+			apierr.New(apierr.CodeOverflow, "a ^ b overflows int").WriteJSON(w)
+			return
+		}
+		result = int(bigResult.Int64())
+	case "sqrt":
+		if a < 0 {
+			apierr.New(apierr.CodeInvalidBody, "a must not be negative for sqrt").WriteJSON(w)
+			return
+		}
+		result = int(math.Sqrt(float64(a)))
+	default:
+		apierr.New(apierr.CodeInvalidBody, fmt.Sprintf("unsupported operation %q", op)).WriteJSON(w)
+		return
+	}
+
+	writeResult(w, result)
+}
+
+// intBinaryOp runs the legacy add/subtract/multiply/divide handlers'
+// overflow-checked arithmetic for a single pair of operands.
+func intBinaryOp(op string, a, b int) (int, *apierr.Error) {
+	switch op {
+	case "add":
+		result := a + b
+		if (b > 0 && result < a) || (b < 0 && result > a) {
+			return 0, apierr.New(apierr.CodeOverflow, "a + b overflows int")
+		}
+		return result, nil
+	case "subtract":
+		result := a - b
+		if (b < 0 && result < a) || (b > 0 && result > a) {
+			return 0, apierr.New(apierr.CodeOverflow, "a - b overflows int")
+		}
+		return result, nil
+	case "multiply":
+		if (a == math.MinInt && b == -1) || (b == math.MinInt && a == -1) {
+			return 0, apierr.New(apierr.CodeOverflow, "a * b overflows int")
+		}
+		result := a * b
+		if a != 0 && result/a != b {
+			return 0, apierr.New(apierr.CodeOverflow, "a * b overflows int")
+		}
+		return result, nil
+	case "divide":
+		if b == 0 {
+			return 0, apierr.New(apierr.CodeDivisionByZero, "")
+		}
+		return a / b, nil
+	default:
+		return 0, apierr.New(apierr.CodeInvalidBody, fmt.Sprintf("unsupported operation %q", op))
+	}
+}
+
+func calculateBigInt(w http.ResponseWriter, req *CalculationRequestV2, op string) {
+	a, ok := new(big.Int).SetString(req.A, 10)
+	if !ok {
+		apierr.New(apierr.CodeInvalidBody, "a is not a valid integer").WriteJSON(w)
+		return
+	}
+
+	b, ok := new(big.Int).SetString(req.B, 10)
+	if !ok && op != "sqrt" {
+		apierr.New(apierr.CodeInvalidBody, "b is not a valid integer").WriteJSON(w)
+		return
+	}
+
+	result := new(big.Int)
+	switch op {
+	case "add":
+		result.Add(a, b)
+	case "subtract":
+		result.Sub(a, b)
+	case "multiply":
+		result.Mul(a, b)
+	case "divide":
+		if b.Sign() == 0 {
+			apierr.New(apierr.CodeDivisionByZero, "").WriteJSON(w)
+			return
+		}
+		// Quo truncates toward zero, matching int mode and the legacy
+		// handlers; Div/Mod are Euclidean and round differently on
+		// negative operands.
+		result.Quo(a, b)
+	case "mod":
+		if b.Sign() == 0 {
+			apierr.New(apierr.CodeDivisionByZero, "").WriteJSON(w)
+			return
+		}
+		result.Rem(a, b)
+	case "power":
+		if b.Sign() < 0 {
+			apierr.New(apierr.CodeInvalidBody, "b must not be negative for bigint power").WriteJSON(w)
+			return
+		}
+		result.Exp(a, b, nil)
+	case "sqrt":
+		if a.Sign() < 0 {
+			apierr.New(apierr.CodeInvalidBody, "a must not be negative for sqrt").WriteJSON(w)
+			return
+		}
+		result.Sqrt(a)
+	default:
+		apierr.New(apierr.CodeInvalidBody, fmt.Sprintf("unsupported operation %q", op)).WriteJSON(w)
+		return
+	}
+
+	httpx.WriteJSON(w, http.StatusOK, CalculationResponseV2{Result: result.String()})
+}
+
+func calculateDecimal(w http.ResponseWriter, req *CalculationRequestV2, op string) {
+	a, err := decimal.NewFromString(req.A)
+	if err != nil {
+		apierr.New(apierr.CodeInvalidBody, "a is not a valid decimal").WriteJSON(w)
+		return
+	}
+
+	var b decimal.Decimal
+	if op != "sqrt" {
+		b, err = decimal.NewFromString(req.B)
+		if err != nil {
+			apierr.New(apierr.CodeInvalidBody, "b is not a valid decimal").WriteJSON(w)
+			return
+		}
+	}
+
+	var result decimal.Decimal
+	switch op {
+	case "add":
+		result = a.Add(b)
+	case "subtract":
+		result = a.Sub(b)
+	case "multiply":
+		result = a.Mul(b)
+	case "divide":
+		if b.IsZero() {
+			apierr.New(apierr.CodeDivisionByZero, "").WriteJSON(w)
+			return
+		}
+		result = a.DivRound(b, int32(req.Precision))
+	case "mod":
+		if b.IsZero() {
+			apierr.New(apierr.CodeDivisionByZero, "").WriteJSON(w)
+			return
+		}
+		result = a.Mod(b)
+	case "power":
+		result = a.Pow(b)
+	case "sqrt":
+		if a.IsNegative() {
+			apierr.New(apierr.CodeInvalidBody, "a must not be negative for sqrt").WriteJSON(w)
+			return
+		}
+		// PowWithPrecision(0.5, precision) computes the root to the
+		// requested precision directly; routing through Float64 would
+		// throw away the arbitrary precision decimal mode promises.
+		sqrt, err := a.PowWithPrecision(decimal.NewFromFloat(0.5), int32(req.Precision))
+		if err != nil {
+			apierr.New(apierr.CodeInvalidBody, err.Error()).WriteJSON(w)
+			return
+		}
+		result = sqrt
+	default:
+		apierr.New(apierr.CodeInvalidBody, fmt.Sprintf("unsupported operation %q", op)).WriteJSON(w)
+		return
+	}
+
+	httpx.WriteJSON(w, http.StatusOK, CalculationResponseV2{
+		Result: result.StringFixed(int32(req.Precision)),
+	})
+}
+
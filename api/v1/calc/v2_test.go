@@ -0,0 +1,157 @@
+package calc
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-chi/chi"
+
+	"github.com/AnimeshRy/goStuff/internal/apierr"
+	"github.com/AnimeshRy/goStuff/internal/httpx"
+)
+
+func newTestRouterV2() http.Handler {
+	r := chi.NewRouter()
+	r.Use(httpx.LoggingMiddleware)
+	RoutesV2(r)
+	return r
+}
+
+func postV2(t *testing.T, router http.Handler, op string, req CalculationRequestV2) *httptest.ResponseRecorder {
+	t.Helper()
+
+	body, err := json.Marshal(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	httpReq := httptest.NewRequest(http.MethodPost, "/"+op, bytes.NewBuffer(body))
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	rr := httptest.NewRecorder()
+	router.ServeHTTP(rr, httpReq)
+	return rr
+}
+
+func TestCalculatorAPIV2(t *testing.T) {
+	router := newTestRouterV2()
+
+	t.Run("legacy add overflows int", func(t *testing.T) {
+		rr := postV2(t, router, "add", CalculationRequestV2{
+			A: "9223372036854775807", B: "1", Mode: ModeInt,
+		})
+
+		if rr.Code != http.StatusBadRequest {
+			t.Fatalf("expected status %d, got %d", http.StatusBadRequest, rr.Code)
+		}
+
+		var apiError apierr.Error
+		if err := json.NewDecoder(rr.Body).Decode(&apiError); err != nil {
+			t.Fatal(err)
+		}
+		if apiError.Code != apierr.CodeOverflow {
+			t.Errorf("expected error code %d, got %d", apierr.CodeOverflow, apiError.Code)
+		}
+	})
+
+	t.Run("bigint add handles values beyond int64", func(t *testing.T) {
+		rr := postV2(t, router, "add", CalculationRequestV2{
+			A: "9223372036854775807", B: "1", Mode: ModeBigInt,
+		})
+
+		if rr.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d", http.StatusOK, rr.Code)
+		}
+
+		var resp CalculationResponseV2
+		if err := json.NewDecoder(rr.Body).Decode(&resp); err != nil {
+			t.Fatal(err)
+		}
+		if resp.Result != "9223372036854775808" {
+			t.Errorf("expected 9223372036854775808, got %s", resp.Result)
+		}
+	})
+
+	t.Run("decimal divide respects precision", func(t *testing.T) {
+		rr := postV2(t, router, "divide", CalculationRequestV2{
+			A: "10", B: "3", Mode: ModeDecimal, Precision: 4,
+		})
+
+		if rr.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d", http.StatusOK, rr.Code)
+		}
+
+		var resp CalculationResponseV2
+		if err := json.NewDecoder(rr.Body).Decode(&resp); err != nil {
+			t.Fatal(err)
+		}
+		if resp.Result != "3.3333" {
+			t.Errorf("expected 3.3333, got %s", resp.Result)
+		}
+	})
+
+	t.Run("bigint divide and mod truncate toward zero like int mode", func(t *testing.T) {
+		divRR := postV2(t, router, "divide", CalculationRequestV2{
+			A: "-7", B: "2", Mode: ModeBigInt,
+		})
+
+		var divResp CalculationResponseV2
+		if err := json.NewDecoder(divRR.Body).Decode(&divResp); err != nil {
+			t.Fatal(err)
+		}
+		if divResp.Result != "-3" {
+			t.Errorf("expected -3, got %s", divResp.Result)
+		}
+
+		modRR := postV2(t, router, "mod", CalculationRequestV2{
+			A: "-7", B: "2", Mode: ModeBigInt,
+		})
+
+		var modResp CalculationResponseV2
+		if err := json.NewDecoder(modRR.Body).Decode(&modResp); err != nil {
+			t.Fatal(err)
+		}
+		if modResp.Result != "-1" {
+			t.Errorf("expected -1, got %s", modResp.Result)
+		}
+	})
+
+	t.Run("decimal sqrt honors precision instead of rounding through float64", func(t *testing.T) {
+		rr := postV2(t, router, "sqrt", CalculationRequestV2{
+			A: "2", Mode: ModeDecimal, Precision: 10,
+		})
+
+		if rr.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d", http.StatusOK, rr.Code)
+		}
+
+		var resp CalculationResponseV2
+		if err := json.NewDecoder(rr.Body).Decode(&resp); err != nil {
+			t.Fatal(err)
+		}
+		if resp.Result != "1.4142135624" {
+			t.Errorf("expected 1.4142135624, got %s", resp.Result)
+		}
+	})
+
+	t.Run("negative precision is rejected", func(t *testing.T) {
+		rr := postV2(t, router, "divide", CalculationRequestV2{
+			A: "10", B: "3", Mode: ModeDecimal, Precision: -1,
+		})
+
+		if rr.Code != http.StatusBadRequest {
+			t.Fatalf("expected status %d, got %d", http.StatusBadRequest, rr.Code)
+		}
+
+		var apiError apierr.Error
+		if err := json.NewDecoder(rr.Body).Decode(&apiError); err != nil {
+			t.Fatal(err)
+		}
+		if apiError.Code != apierr.CodeInvalidBody {
+			t.Errorf("expected error code %d, got %d", apierr.CodeInvalidBody, apiError.Code)
+		}
+	})
+}
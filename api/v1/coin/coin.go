@@ -0,0 +1,59 @@
+// Package coin implements the /v1/coin/* coin-balance endpoints.
+package coin
+
+import (
+	"net/http"
+	"sync"
+
+	"github.com/go-chi/chi"
+
+	"github.com/AnimeshRy/goStuff/internal/apierr"
+	"github.com/AnimeshRy/goStuff/internal/httpx"
+)
+
+// CoinBalanceParams identifies whose balance is being requested.
+type CoinBalanceParams struct {
+	Username string `json:"username"`
+}
+
+// CoinBalanceResponse reports an account's current balance.
+type CoinBalanceResponse struct {
+	// Success Code, Usually 200
+	Code int `json:"code"`
+
+	// Account Balance
+	Balance int64 `json:"balance"`
+}
+
+// store is a placeholder in-memory ledger until this is backed by a real
+// accounts database.
+var store = struct {
+	mu       sync.RWMutex
+	balances map[string]int64
+}{balances: map[string]int64{}}
+
+// Routes registers the coin-balance endpoints on r.
+func Routes(r chi.Router) {
+	r.Post("/balance", balanceHandler)
+}
+
+func balanceHandler(w http.ResponseWriter, r *http.Request) {
+	req, ok := httpx.Validate[CoinBalanceParams](w, r)
+	if !ok {
+		return
+	}
+
+	if req.Username == "" {
+		apierr.New(apierr.CodeInvalidBody, "username is required").WriteJSON(w)
+		return
+	}
+
+	store.mu.RLock()
+	balance := store.balances[req.Username]
+	store.mu.RUnlock()
+
+	httpx.WriteJSON(w, http.StatusOK, CoinBalanceResponse{
+		Code:    http.StatusOK,
+		Balance: balance,
+	})
+}
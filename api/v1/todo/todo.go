@@ -0,0 +1,142 @@
+// Package todo implements the /v1/todo/* endpoints backed by MongoDB.
+package todo
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi"
+	"github.com/thedevsaddam/renderer"
+	mgo "gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+
+	"github.com/AnimeshRy/goStuff/internal/httpx"
+)
+
+const collectionName string = "todo"
+
+type todoModel struct {
+	ID        bson.ObjectId `bson:"_id,omitempty"`
+	Title     string        `bson:"title"`
+	Completed bool          `bson:"completed"`
+	CreatedAt time.Time     `bson:"createAt"`
+}
+
+type todo struct {
+	ID        string    `json:"id"`
+	Title     string    `json:"title"`
+	Completed bool      `json:"completed"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Handler holds the dependencies shared by the todo endpoints.
+type Handler struct {
+	db  *mgo.Database
+	rnd *renderer.Render
+}
+
+// New builds a todo Handler backed by db.
+func New(db *mgo.Database) *Handler {
+	return &Handler{db: db, rnd: renderer.New()}
+}
+
+// Routes registers the todo endpoints on r.
+func (h *Handler) Routes(r chi.Router) {
+	r.Get("/", h.fetchTodos)
+	r.Post("/", h.createTodo)
+	r.Put("/{id}", h.updateTodo)
+	r.Delete("/{id}", h.deleteTodo)
+}
+
+func (h *Handler) fetchTodos(w http.ResponseWriter, r *http.Request) {
+	todos := []todoModel{}
+
+	if err := h.db.C(collectionName).Find(bson.M{}).All(&todos); err != nil {
+		httpx.WriteError(w, "Failed to fetch todos", http.StatusInternalServerError)
+		return
+	}
+
+	todoList := make([]todo, 0, len(todos))
+	for _, t := range todos {
+		todoList = append(todoList, todo{
+			ID:        t.ID.Hex(),
+			Title:     t.Title,
+			Completed: t.Completed,
+			CreatedAt: t.CreatedAt,
+		})
+	}
+
+	httpx.WriteJSON(w, http.StatusOK, todoList)
+}
+
+func (h *Handler) createTodo(w http.ResponseWriter, r *http.Request) {
+	req, ok := httpx.Validate[todo](w, r)
+	if !ok {
+		return
+	}
+
+	if req.Title == "" {
+		httpx.WriteError(w, "Title is required", http.StatusBadRequest)
+		return
+	}
+
+	tm := todoModel{
+		ID:        bson.NewObjectId(),
+		Title:     req.Title,
+		Completed: false,
+		CreatedAt: time.Now(),
+	}
+
+	if err := h.db.C(collectionName).Insert(&tm); err != nil {
+		httpx.WriteError(w, "Failed to save todo", http.StatusInternalServerError)
+		return
+	}
+
+	httpx.WriteJSON(w, http.StatusCreated, todo{
+		ID:        tm.ID.Hex(),
+		Title:     tm.Title,
+		Completed: tm.Completed,
+		CreatedAt: tm.CreatedAt,
+	})
+}
+
+func (h *Handler) updateTodo(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if !bson.IsObjectIdHex(id) {
+		httpx.WriteError(w, "Invalid todo ID", http.StatusBadRequest)
+		return
+	}
+
+	req, ok := httpx.Validate[todo](w, r)
+	if !ok {
+		return
+	}
+
+	if req.Title == "" {
+		httpx.WriteError(w, "Title is required", http.StatusBadRequest)
+		return
+	}
+
+	update := bson.M{"title": req.Title, "completed": req.Completed}
+	if err := h.db.C(collectionName).UpdateId(bson.ObjectIdHex(id), bson.M{"$set": update}); err != nil {
+		httpx.WriteError(w, "Failed to update todo", http.StatusInternalServerError)
+		return
+	}
+
+	httpx.WriteJSON(w, http.StatusOK, httpx.MessageResponse{Message: "Todo updated successfully"})
+}
+
+func (h *Handler) deleteTodo(w http.ResponseWriter, r *http.Request) {
+	id := chi.URLParam(r, "id")
+	if !bson.IsObjectIdHex(id) {
+		httpx.WriteError(w, "Invalid todo ID", http.StatusBadRequest)
+		return
+	}
+
+	if err := h.db.C(collectionName).RemoveId(bson.ObjectIdHex(id)); err != nil {
+		httpx.WriteError(w, "Failed to delete todo", http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,88 @@
+// Command server composes every api/v1/* package behind one http.Server.
+package main
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/go-chi/chi"
+	"golang.org/x/time/rate"
+	mgo "gopkg.in/mgo.v2"
+
+	"github.com/AnimeshRy/goStuff/api/v1/calc"
+	"github.com/AnimeshRy/goStuff/api/v1/coin"
+	"github.com/AnimeshRy/goStuff/api/v1/todo"
+	"github.com/AnimeshRy/goStuff/internal/httpx"
+	"github.com/AnimeshRy/goStuff/internal/middleware"
+)
+
+const (
+	addr       = ":8080"
+	mongoHost  = "localhost:27017"
+	mongoDBame = "demo_todo"
+
+	rateLimitRPS   rate.Limit = 10
+	rateLimitBurst            = 20
+)
+
+func main() {
+	logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
+		Level: slog.LevelDebug,
+	}))
+	slog.SetDefault(logger)
+
+	sess, err := mgo.Dial(mongoHost)
+	if err != nil {
+		logger.Error("Error connecting to MongoDB", "error", err)
+		os.Exit(1)
+	}
+	defer sess.Close()
+	sess.SetMode(mgo.Monotonic, true)
+
+	r := chi.NewRouter()
+	r.Use(middleware.RequestID)
+	r.Use(middleware.Recoverer)
+	r.Use(middleware.RateLimit(rateLimitRPS, rateLimitBurst))
+	r.Use(middleware.AccessLog)
+
+	r.Route("/v1/calc", calc.Routes)
+	r.Route("/v2/calc", calc.RoutesV2)
+	r.Route("/v1/coin", coin.Routes)
+	r.Route("/v1/todo", todo.New(sess.DB(mongoDBame)).Routes)
+
+	server := &http.Server{
+		Addr:         addr,
+		Handler:      httpx.CORS().Handler(r),
+		ReadTimeout:  5 * time.Second,
+		WriteTimeout: 10 * time.Second,
+		IdleTimeout:  15 * time.Second,
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	go func() {
+		logger.Info("Starting server", "addr", addr)
+		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			logger.Error("Error starting server", "error", err)
+			os.Exit(1)
+		}
+	}()
+
+	<-ctx.Done()
+	stop()
+
+	logger.Info("Shutting down server")
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		logger.Error("Error during server shutdown", "error", err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,69 @@
+// Package apierr provides a typed error envelope with stable error codes,
+// so API clients can switch on Code instead of matching free-form strings.
+package apierr
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// Error codes returned to clients. These values are part of the API
+// contract and must not be renumbered once shipped.
+const (
+	CodeInvalidBody      = 1001
+	CodeMethodNotAllowed = 1002
+	CodeInternal         = 1003
+	CodeRateLimited      = 1004
+	CodeDivisionByZero   = 2001
+	CodeOverflow         = 2002
+)
+
+// registry maps a code to its default message and HTTP status.
+var registry = map[int]struct {
+	message    string
+	httpStatus int
+}{
+	CodeInvalidBody:      {"Invalid request body", http.StatusBadRequest},
+	CodeMethodNotAllowed: {"Method not allowed", http.StatusMethodNotAllowed},
+	CodeInternal:         {"Internal server error", http.StatusInternalServerError},
+	CodeRateLimited:      {"Too many requests", http.StatusTooManyRequests},
+	CodeDivisionByZero:   {"Division by zero is not allowed", http.StatusBadRequest},
+	CodeOverflow:         {"Result overflows the supported range", http.StatusBadRequest},
+}
+
+// Error is the typed envelope rendered to clients as JSON.
+type Error struct {
+	Code       int    `json:"errorCode"`
+	Message    string `json:"message"`
+	Cause      string `json:"cause,omitempty"`
+	HTTPStatus int    `json:"-"`
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+// New builds an Error for code, looking up its message and HTTP status in
+// the registry. cause, if non-empty, carries extra context (e.g. the field
+// that failed to parse) without overriding the stable message.
+func New(code int, cause string) *Error {
+	entry, ok := registry[code]
+	if !ok {
+		entry.message = "Unknown error"
+		entry.httpStatus = http.StatusInternalServerError
+	}
+
+	return &Error{
+		Code:       code,
+		Message:    entry.message,
+		Cause:      cause,
+		HTTPStatus: entry.httpStatus,
+	}
+}
+
+// WriteJSON renders e as the HTTP response, using e.HTTPStatus.
+func (e *Error) WriteJSON(w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(e.HTTPStatus)
+	json.NewEncoder(w).Encode(e)
+}
@@ -0,0 +1,126 @@
+// Package httpx holds the HTTP plumbing shared by every api/v1/* package:
+// JSON helpers, request validation, and the logging/CORS middleware chain.
+package httpx
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/rs/cors"
+
+	"github.com/AnimeshRy/goStuff/internal/apierr"
+)
+
+// ErrorResponse is the legacy, untyped error envelope. Newer handlers should
+// prefer internal/apierr, but this stays around for routes that haven't been
+// migrated yet.
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
+
+// MessageResponse carries a plain success message, distinct from
+// ErrorResponse so a client switching on the presence of "error" can't
+// mistake a successful response for a failed one.
+type MessageResponse struct {
+	Message string `json:"message"`
+}
+
+// WriteJSON writes v as a JSON body with the given status code.
+func WriteJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+// WriteError writes a plain ErrorResponse with the given status code.
+func WriteError(w http.ResponseWriter, message string, status int) {
+	WriteJSON(w, status, ErrorResponse{Error: message})
+}
+
+// Validate decodes r's JSON body into a new T, rejecting anything that isn't
+// an HTTP POST or that fails to decode. It writes the typed apierr response
+// itself on failure, mirroring the old calculator's validateRequest.
+func Validate[T any](w http.ResponseWriter, r *http.Request) (*T, bool) {
+	if r.Method != http.MethodPost {
+		apierr.New(apierr.CodeMethodNotAllowed, "").WriteJSON(w)
+		return nil, false
+	}
+
+	var req T
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		apierr.New(apierr.CodeInvalidBody, err.Error()).WriteJSON(w)
+		return nil, false
+	}
+
+	return &req, true
+}
+
+// ResponseRecorder wraps an http.ResponseWriter to capture the status code
+// and byte count written by the handler, so middleware can log them
+// afterwards. It is exported so packages like internal/middleware can build
+// their own logging on top of it without duplicating the bookkeeping.
+type ResponseRecorder struct {
+	http.ResponseWriter
+	statusCode   int
+	bytesWritten int
+}
+
+// NewResponseRecorder wraps w, defaulting to a 200 status in case the
+// handler never calls WriteHeader.
+func NewResponseRecorder(w http.ResponseWriter) *ResponseRecorder {
+	return &ResponseRecorder{ResponseWriter: w, statusCode: http.StatusOK}
+}
+
+func (rw *ResponseRecorder) WriteHeader(code int) {
+	rw.statusCode = code
+	rw.ResponseWriter.WriteHeader(code)
+}
+
+func (rw *ResponseRecorder) Write(b []byte) (int, error) {
+	n, err := rw.ResponseWriter.Write(b)
+	rw.bytesWritten += n
+	return n, err
+}
+
+// StatusCode returns the status code written so far.
+func (rw *ResponseRecorder) StatusCode() int {
+	return rw.statusCode
+}
+
+// BytesWritten returns the number of response body bytes written so far.
+func (rw *ResponseRecorder) BytesWritten() int {
+	return rw.bytesWritten
+}
+
+// LoggingMiddleware logs method, path, status and duration for every request
+// using the default slog logger.
+func LoggingMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+
+		rw := NewResponseRecorder(w)
+
+		next.ServeHTTP(rw, r)
+
+		slog.Info("Request completed",
+			"method", r.Method,
+			"path", r.URL.Path,
+			"status", rw.StatusCode(),
+			"duration", time.Since(start),
+			"ip", r.RemoteAddr,
+			"user_agent", r.UserAgent(),
+		)
+	})
+}
+
+// CORS returns the CORS handler shared by every route in the server.
+func CORS() *cors.Cors {
+	return cors.New(cors.Options{
+		AllowedOrigins: []string{"*"},
+		AllowedMethods: []string{"GET", "POST", "PATCH", "DELETE", "OPTIONS"},
+		AllowedHeaders: []string{"Content-Type"},
+		MaxAge:         300,
+	})
+}
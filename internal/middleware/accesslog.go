@@ -0,0 +1,44 @@
+package middleware
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/go-chi/chi"
+
+	"github.com/AnimeshRy/goStuff/internal/httpx"
+)
+
+// AccessLog logs one structured line per request, enriched with the
+// request ID set by RequestID, the number of response bytes written, and
+// the matched chi route pattern.
+func AccessLog(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+
+		rw := httpx.NewResponseRecorder(w)
+		next.ServeHTTP(rw, r)
+
+		slog.Info("Request completed",
+			"method", r.Method,
+			"path", r.URL.Path,
+			"route", routePattern(r),
+			"status", rw.StatusCode(),
+			"duration", time.Since(start),
+			"bytes_written", rw.BytesWritten(),
+			"request_id", RequestIDFromContext(r.Context()),
+			"ip", r.RemoteAddr,
+			"user_agent", r.UserAgent(),
+		)
+	})
+}
+
+func routePattern(r *http.Request) string {
+	if rctx := chi.RouteContext(r.Context()); rctx != nil {
+		if pattern := rctx.RoutePattern(); pattern != "" {
+			return pattern
+		}
+	}
+	return r.URL.Path
+}
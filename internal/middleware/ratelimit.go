@@ -0,0 +1,59 @@
+package middleware
+
+import (
+	"net"
+	"net/http"
+	"sync"
+
+	"golang.org/x/time/rate"
+
+	"github.com/AnimeshRy/goStuff/internal/apierr"
+)
+
+// RateLimit throttles requests per remote IP using a token-bucket limiter,
+// rejecting anything over the bucket with a typed 429.
+func RateLimit(rps rate.Limit, burst int) func(http.Handler) http.Handler {
+	limiters := &perIPLimiters{
+		rps:   rps,
+		burst: burst,
+		byIP:  make(map[string]*rate.Limiter),
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !limiters.forIP(clientIP(r)).Allow() {
+				apierr.New(apierr.CodeRateLimited, "").WriteJSON(w)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+type perIPLimiters struct {
+	mu    sync.Mutex
+	rps   rate.Limit
+	burst int
+	byIP  map[string]*rate.Limiter
+}
+
+func (l *perIPLimiters) forIP(ip string) *rate.Limiter {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	limiter, ok := l.byIP[ip]
+	if !ok {
+		limiter = rate.NewLimiter(l.rps, l.burst)
+		l.byIP[ip] = limiter
+	}
+	return limiter
+}
+
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
@@ -0,0 +1,53 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"golang.org/x/time/rate"
+)
+
+func TestRateLimitDrainsBucket(t *testing.T) {
+	ok := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	handler := RateLimit(rate.Limit(1), 5)(ok)
+
+	var okCount, limitedCount int64
+	var wg sync.WaitGroup
+
+	for i := 0; i < 100; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.RemoteAddr = "203.0.113.5:12345"
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+
+			switch rr.Code {
+			case http.StatusOK:
+				atomic.AddInt64(&okCount, 1)
+			case http.StatusTooManyRequests:
+				atomic.AddInt64(&limitedCount, 1)
+			default:
+				t.Errorf("unexpected status %d", rr.Code)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if limitedCount == 0 {
+		t.Errorf("expected at least one 429 once the bucket of burst 5 drained across 100 requests, got %d", limitedCount)
+	}
+	if okCount+limitedCount != 100 {
+		t.Errorf("expected 100 total responses, got %d ok + %d limited", okCount, limitedCount)
+	}
+}
@@ -0,0 +1,27 @@
+package middleware
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/AnimeshRy/goStuff/internal/apierr"
+)
+
+// Recoverer converts a panic in any downstream handler into a typed 500
+// apierr response instead of taking down the whole server.
+func Recoverer(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() {
+			if rec := recover(); rec != nil {
+				slog.Error("Recovered from panic",
+					"request_id", RequestIDFromContext(r.Context()),
+					"panic", fmt.Sprint(rec),
+				)
+				apierr.New(apierr.CodeInternal, fmt.Sprint(rec)).WriteJSON(w)
+			}
+		}()
+
+		next.ServeHTTP(w, r)
+	})
+}
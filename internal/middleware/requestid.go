@@ -0,0 +1,38 @@
+// Package middleware holds the composable HTTP middlewares chained around
+// the calculator server's routes: request correlation, panic recovery, rate
+// limiting, and access logging.
+package middleware
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+type contextKey string
+
+const requestIDKey contextKey = "requestID"
+
+// RequestIDHeader is the response header carrying the generated request ID.
+const RequestIDHeader = "X-Request-ID"
+
+// RequestID generates a UUID for every request, stores it in the request's
+// context, and echoes it back as RequestIDHeader.
+func RequestID(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := uuid.NewString()
+
+		w.Header().Set(RequestIDHeader, id)
+		ctx := context.WithValue(r.Context(), requestIDKey, id)
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// RequestIDFromContext returns the request ID stored by RequestID, or ""
+// if none is present.
+func RequestIDFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey).(string)
+	return id
+}
@@ -1,9 +1,11 @@
 package main
 
 import (
-	"encoding/csv"
+	"context"
 	"fmt"
+	"net/http"
 	"os"
+	"os/signal"
 	"path/filepath"
 	"strconv"
 	"syscall"
@@ -12,16 +14,15 @@ import (
 
 	"github.com/mergestat/timediff"
 	"github.com/spf13/cobra"
-)
 
-type Task struct {
-	ID          int
-	Description string
-	CreatedAt   time.Time
-	IsCompleted bool
-}
+	"github.com/AnimeshRy/goStuff/todo-go/server"
+	"github.com/AnimeshRy/goStuff/todo-go/storage"
+)
 
-var dataFile string
+var (
+	storeName string
+	dataDir   string
+)
 
 func init() {
 	homeDir, err := os.Getwd()
@@ -29,101 +30,23 @@ func init() {
 		fmt.Fprintln(os.Stderr, "Error getting home directory:", err)
 		os.Exit(1)
 	}
-	dataFile = filepath.Join(homeDir, ".tasks.csv")
-}
-
-func loadFile(filepath string) (*os.File, error) {
-	f, err := os.OpenFile(filepath, os.O_RDWR|os.O_CREATE, os.ModePerm)
-	if err != nil {
-		return nil, fmt.Errorf("failed to open file for reading")
-	}
-
-	if err := syscall.Flock(int(f.Fd()), syscall.LOCK_EX); err != nil {
-		_ = f.Close()
-		return nil, err
-	}
-
-	return f, nil
-}
-
-func closeFile(f *os.File) error {
-	syscall.Flock(int(f.Fd()), syscall.LOCK_UN)
-	return f.Close()
-}
-
-func loadTasks() ([]Task, error) {
-	f, err := loadFile(dataFile)
-	if err != nil {
-		return nil, err
-	}
-	defer closeFile(f)
-
-	reader := csv.NewReader(f)
-	records, err := reader.ReadAll()
-
-	if err != nil {
-		return nil, fmt.Errorf("failed to read CSV: %w", err)
-	}
-
-	var tasks []Task
-	for i, record := range records {
-		if i == 0 {
-			continue // Skip Headers
-		}
-		id, _ := strconv.Atoi(record[0])
-		createdAt, _ := time.Parse(time.RFC3339, record[2])
-		isComplete, _ := strconv.ParseBool(record[3])
-
-		tasks = append(tasks, Task{
-			ID:          id,
-			Description: record[1],
-			CreatedAt:   createdAt,
-			IsCompleted: isComplete,
-		})
-	}
-
-	return tasks, nil
-}
-
-func saveTasks(tasks []Task) error {
-	f, err := loadFile(dataFile)
-	if err != nil {
-		return err
-	}
-	defer closeFile(f)
-
-	writer := csv.NewWriter(f)
-	defer writer.Flush()
-
-	// Write Headers
-	err = writer.Write([]string{"ID", "Description", "CreatedAt", "IsCompleted"})
-	if err != nil {
-		return fmt.Errorf("failed to write CSV header: %w", err)
-	}
 
-	// Write Tasks
-	for _, task := range tasks {
-		err := writer.Write([]string{
-			strconv.Itoa(task.ID),
-			task.Description,
-			task.CreatedAt.Format(time.RFC3339),
-			strconv.FormatBool(task.IsCompleted),
-		})
-		if err != nil {
-			return fmt.Errorf("failed to write task to CSV: %w", err)
-		}
-	}
-	return nil
+	rootCmd.PersistentFlags().StringVar(&storeName, "store", "csv", "storage backend: csv, bolt, or sqlite")
+	rootCmd.PersistentFlags().StringVar(&dataDir, "data-dir", homeDir, "directory holding the task data file")
 }
 
-func getNextID(tasks []Task) int {
-	maxID := 0
-	for _, task := range tasks {
-		if task.ID > maxID {
-			maxID = task.ID
-		}
+// openStore builds the TaskStore named by name, rooted at --data-dir.
+func openStore(name string) (storage.TaskStore, error) {
+	switch name {
+	case "csv":
+		return storage.NewCSVStore(filepath.Join(dataDir, ".tasks.csv"))
+	case "bolt":
+		return storage.NewBoltStore(filepath.Join(dataDir, ".tasks.bolt"))
+	case "sqlite":
+		return storage.NewSQLiteStore(filepath.Join(dataDir, ".tasks.sqlite"))
+	default:
+		return nil, fmt.Errorf("unknown store %q: must be csv, bolt, or sqlite", name)
 	}
-	return maxID + 1
 }
 
 var rootCmd = &cobra.Command{
@@ -136,24 +59,18 @@ var addCmd = &cobra.Command{
 	Short: "Add a new task",
 	Args:  cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		tasks, err := loadTasks()
+		store, err := openStore(storeName)
 		if err != nil {
 			return err
 		}
+		defer store.Close()
 
-		newTask := Task{
-			ID:          getNextID(tasks),
-			Description: args[0],
-			CreatedAt:   time.Now(),
-			IsCompleted: false,
-		}
-
-		tasks = append(tasks, newTask)
-		if err := saveTasks(tasks); err != nil {
+		task, err := store.Add(args[0])
+		if err != nil {
 			return err
 		}
 
-		fmt.Printf("Added tasks %d: %s\n", newTask.ID, newTask.Description)
+		fmt.Printf("Added tasks %d: %s\n", task.ID, task.Description)
 		return nil
 	},
 }
@@ -163,7 +80,14 @@ var listCmd = &cobra.Command{
 	Short: "List all tasks",
 	RunE: func(cmd *cobra.Command, args []string) error {
 		showAll, _ := cmd.Flags().GetBool("all")
-		tasks, err := loadTasks()
+
+		store, err := openStore(storeName)
+		if err != nil {
+			return err
+		}
+		defer store.Close()
+
+		tasks, err := store.List(storage.Filter{IncludeCompleted: showAll})
 		if err != nil {
 			return err
 		}
@@ -176,10 +100,6 @@ var listCmd = &cobra.Command{
 		}
 
 		for _, task := range tasks {
-			if !showAll && task.IsCompleted {
-				continue
-			}
-
 			timeAgo := timediff.TimeDiff(task.CreatedAt)
 			if showAll {
 				fmt.Fprintf(w, "%d\t%s\t%s\t%v\n",
@@ -203,25 +123,16 @@ var completeCmd = &cobra.Command{
 			return fmt.Errorf("invalid task ID: %s", args[0])
 		}
 
-		tasks, err := loadTasks()
+		store, err := openStore(storeName)
 		if err != nil {
 			return err
 		}
+		defer store.Close()
 
-		found := false
-		for i := range tasks {
-			if tasks[i].ID == id {
-				tasks[i].IsCompleted = true
-				found = true
-				break
+		if err := store.Complete(id); err != nil {
+			if err == storage.ErrNotFound {
+				return fmt.Errorf("task with ID %d not found", id)
 			}
-		}
-
-		if !found {
-			return fmt.Errorf("task with ID %d not found", id)
-		}
-
-		if err := saveTasks(tasks); err != nil {
 			return err
 		}
 
@@ -240,45 +151,112 @@ var deleteCmd = &cobra.Command{
 			return fmt.Errorf("invalid task ID: %s", args[0])
 		}
 
-		tasks, err := loadTasks()
+		store, err := openStore(storeName)
 		if err != nil {
 			return err
 		}
+		defer store.Close()
 
-		found := false
-		newTasks := make([]Task, 0, len(tasks)-1)
-		for _, task := range tasks {
-			if task.ID == id {
-				found = true
-				continue
+		if err := store.Delete(id); err != nil {
+			if err == storage.ErrNotFound {
+				return fmt.Errorf("task with ID %d not found", id)
 			}
-			newTasks = append(newTasks, task)
+			return err
 		}
 
-		if !found {
-			return fmt.Errorf("task with ID %d not found", id)
+		fmt.Printf("Deleted task %d\n", id)
+		return nil
+	},
+}
+
+var (
+	migrateFrom string
+	migrateTo   string
+)
+
+var migrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "Copy every task from one storage backend to another",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		from, err := openStore(migrateFrom)
+		if err != nil {
+			return err
 		}
+		defer from.Close()
 
-		if err := saveTasks(newTasks); err != nil {
+		to, err := openStore(migrateTo)
+		if err != nil {
 			return err
 		}
+		defer to.Close()
 
-		fmt.Printf("Deleted task %d\n", id)
+		if err := storage.Migrate(from, to); err != nil {
+			return err
+		}
+
+		fmt.Printf("Migrated tasks from %s to %s\n", migrateFrom, migrateTo)
 		return nil
 	},
 }
 
+var servePort int
+
+var serveCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "Serve the tasks API, SSE stream, and web UI over HTTP",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		store, err := openStore(storeName)
+		if err != nil {
+			return err
+		}
+		defer store.Close()
+
+		taskServer := server.New(store)
+		srv := &http.Server{
+			Addr:    fmt.Sprintf(":%d", servePort),
+			Handler: taskServer.Handler(),
+		}
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		go taskServer.Watch(ctx, time.Second)
+
+		go func() {
+			fmt.Printf("Serving tasks on http://localhost:%d\n", servePort)
+			if err := srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				fmt.Fprintln(os.Stderr, "Error starting server:", err)
+				os.Exit(1)
+			}
+		}()
+
+		<-ctx.Done()
+		stop()
+
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		return srv.Shutdown(shutdownCtx)
+	},
+}
+
 func main() {
 	listCmd.Flags().BoolP("all", "a", false, "Show all tasks including completed ones")
 
+	migrateCmd.Flags().StringVar(&migrateFrom, "from", "csv", "source storage backend: csv, bolt, or sqlite")
+	migrateCmd.Flags().StringVar(&migrateTo, "to", "bolt", "destination storage backend: csv, bolt, or sqlite")
+
+	serveCmd.Flags().IntVar(&servePort, "port", 8090, "port to serve the tasks API and UI on")
+
 	rootCmd.AddCommand(addCmd)
 	rootCmd.AddCommand(listCmd)
 	rootCmd.AddCommand(completeCmd)
 	rootCmd.AddCommand(deleteCmd)
+	rootCmd.AddCommand(migrateCmd)
+	rootCmd.AddCommand(serveCmd)
 
 	if err := rootCmd.Execute(); err != nil {
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(1)
 	}
-
 }
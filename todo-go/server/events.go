@@ -0,0 +1,75 @@
+package server
+
+import (
+	"encoding/json"
+	"sync"
+
+	"github.com/AnimeshRy/goStuff/todo-go/storage"
+)
+
+// EventType names the kind of change a Broker publishes to subscribers.
+type EventType string
+
+const (
+	EventTaskAdded     EventType = "task.added"
+	EventTaskCompleted EventType = "task.completed"
+	EventTaskDeleted   EventType = "task.deleted"
+)
+
+// Event is a single change published to every connected SSE client. Task is
+// set for task.added/task.completed; ID is set for task.deleted, where the
+// task no longer exists to serialize.
+type Event struct {
+	Type EventType     `json:"type"`
+	Task *storage.Task `json:"task,omitempty"`
+	ID   int           `json:"id,omitempty"`
+}
+
+// Broker fans out Events to every subscribed channel, so a task added from
+// the CLI shows up in every open browser tab.
+type Broker struct {
+	mu   sync.Mutex
+	subs map[chan Event]struct{}
+}
+
+// NewBroker returns an empty Broker.
+func NewBroker() *Broker {
+	return &Broker{subs: make(map[chan Event]struct{})}
+}
+
+// Subscribe registers a new listener and returns a channel of future Events
+// plus an unsubscribe function the caller must call when done.
+func (b *Broker) Subscribe() (<-chan Event, func()) {
+	ch := make(chan Event, 8)
+
+	b.mu.Lock()
+	b.subs[ch] = struct{}{}
+	b.mu.Unlock()
+
+	unsubscribe := func() {
+		b.mu.Lock()
+		delete(b.subs, ch)
+		close(ch)
+		b.mu.Unlock()
+	}
+
+	return ch, unsubscribe
+}
+
+// Publish fans out ev to every current subscriber. Slow subscribers are
+// dropped in favor of not blocking the publisher.
+func (b *Broker) Publish(ev Event) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for ch := range b.subs {
+		select {
+		case ch <- ev:
+		default:
+		}
+	}
+}
+
+func (e Event) marshal() ([]byte, error) {
+	return json.Marshal(e)
+}
@@ -0,0 +1,227 @@
+// Package server exposes the tasks CLI's storage.TaskStore as an HTTP API,
+// an SSE event stream, and an embedded web UI.
+package server
+
+import (
+	"context"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/AnimeshRy/goStuff/todo-go/storage"
+)
+
+//go:embed web
+var webFS embed.FS
+
+// Server wires a storage.TaskStore to the REST + SSE routes below. Changes
+// made through this Server's own handlers are broadcast to the broker
+// immediately; changes made by a separate "tasks" CLI process against the
+// same store are picked up by Watch's polling loop, so both show up in
+// every connected browser without the CLI needing to know about the broker.
+type Server struct {
+	store  storage.TaskStore
+	broker *Broker
+}
+
+// New builds a Server backed by store.
+func New(store storage.TaskStore) *Server {
+	return &Server{store: store, broker: NewBroker()}
+}
+
+// Handler returns the http.Handler serving the UI, REST API and SSE stream.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/api/v1/tasks", s.handleTasks)
+	mux.HandleFunc("/api/v1/tasks/", s.handleTask)
+	mux.HandleFunc("/events", s.handleEvents)
+
+	ui, err := fs.Sub(webFS, "web")
+	if err != nil {
+		panic(err)
+	}
+	mux.Handle("/", http.FileServer(http.FS(ui)))
+
+	return mux
+}
+
+func (s *Server) handleTasks(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		tasks, err := s.store.List(storage.Filter{IncludeCompleted: true})
+		if err != nil {
+			writeError(w, err, http.StatusInternalServerError)
+			return
+		}
+		writeJSON(w, http.StatusOK, tasks)
+
+	case http.MethodPost:
+		var body struct {
+			Description string `json:"description"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			writeError(w, err, http.StatusBadRequest)
+			return
+		}
+
+		task, err := s.store.Add(body.Description)
+		if err != nil {
+			writeError(w, err, http.StatusInternalServerError)
+			return
+		}
+
+		s.broker.Publish(Event{Type: EventTaskAdded, Task: &task})
+		writeJSON(w, http.StatusCreated, task)
+
+	default:
+		writeError(w, fmt.Errorf("method not allowed"), http.StatusMethodNotAllowed)
+	}
+}
+
+func (s *Server) handleTask(w http.ResponseWriter, r *http.Request) {
+	id, err := strconv.Atoi(strings.TrimPrefix(r.URL.Path, "/api/v1/tasks/"))
+	if err != nil {
+		writeError(w, fmt.Errorf("invalid task id"), http.StatusBadRequest)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodPatch:
+		if err := s.store.Complete(id); err != nil {
+			writeError(w, err, statusFor(err))
+			return
+		}
+
+		task, err := s.store.Get(id)
+		if err != nil {
+			writeError(w, err, statusFor(err))
+			return
+		}
+
+		s.broker.Publish(Event{Type: EventTaskCompleted, Task: &task})
+		writeJSON(w, http.StatusOK, task)
+
+	case http.MethodDelete:
+		if err := s.store.Delete(id); err != nil {
+			writeError(w, err, statusFor(err))
+			return
+		}
+
+		s.broker.Publish(Event{Type: EventTaskDeleted, ID: id})
+		w.WriteHeader(http.StatusNoContent)
+
+	default:
+		writeError(w, fmt.Errorf("method not allowed"), http.StatusMethodNotAllowed)
+	}
+}
+
+func (s *Server) handleEvents(w http.ResponseWriter, r *http.Request) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeError(w, fmt.Errorf("streaming unsupported"), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+
+	events, unsubscribe := s.broker.Subscribe()
+	defer unsubscribe()
+
+	for {
+		select {
+		case ev, ok := <-events:
+			if !ok {
+				return
+			}
+			payload, err := ev.marshal()
+			if err != nil {
+				continue
+			}
+			fmt.Fprintf(w, "event: %s\ndata: %s\n\n", ev.Type, payload)
+			flusher.Flush()
+
+		case <-r.Context().Done():
+			return
+		}
+	}
+}
+
+// Watch polls the store every interval for tasks added, completed, or
+// deleted by other processes (e.g. a concurrent "tasks add" from a
+// terminal) and publishes the matching Events, until ctx is done. Run it
+// in its own goroutine alongside the HTTP server.
+func (s *Server) Watch(ctx context.Context, interval time.Duration) {
+	prev := s.snapshot()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			curr := s.snapshot()
+			s.publishDiff(prev, curr)
+			prev = curr
+		}
+	}
+}
+
+func (s *Server) snapshot() map[int]storage.Task {
+	tasks, err := s.store.List(storage.Filter{IncludeCompleted: true})
+	if err != nil {
+		return nil
+	}
+
+	byID := make(map[int]storage.Task, len(tasks))
+	for _, task := range tasks {
+		byID[task.ID] = task
+	}
+	return byID
+}
+
+func (s *Server) publishDiff(prev, curr map[int]storage.Task) {
+	for id, task := range curr {
+		task := task
+
+		old, existed := prev[id]
+		switch {
+		case !existed:
+			s.broker.Publish(Event{Type: EventTaskAdded, Task: &task})
+		case !old.IsCompleted && task.IsCompleted:
+			s.broker.Publish(Event{Type: EventTaskCompleted, Task: &task})
+		}
+	}
+
+	for id := range prev {
+		if _, ok := curr[id]; !ok {
+			s.broker.Publish(Event{Type: EventTaskDeleted, ID: id})
+		}
+	}
+}
+
+func statusFor(err error) int {
+	if err == storage.ErrNotFound {
+		return http.StatusNotFound
+	}
+	return http.StatusInternalServerError
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, err error, status int) {
+	writeJSON(w, status, map[string]string{"error": err.Error()})
+}
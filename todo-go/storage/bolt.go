@@ -0,0 +1,164 @@
+package storage
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+var tasksBucket = []byte("tasks")
+
+// BoltStore persists tasks in a single BoltDB bucket, keyed by the bucket's
+// auto-incrementing sequence. Writes within a process are serialized by
+// Bolt itself instead of relying on flock, but the underlying file can only
+// be open in one process at a time: a long-lived holder (such as
+// `tasks serve --store=bolt`) will make concurrent CLI invocations against
+// the same file wait up to boltOpenTimeout before failing.
+type BoltStore struct {
+	db *bolt.DB
+}
+
+// boltOpenTimeout bounds how long NewBoltStore waits for bbolt's exclusive
+// file lock before giving up. Without it, a second process (e.g. a CLI
+// command run while `tasks serve --store=bolt` holds the file open) would
+// block indefinitely instead of failing with a clear error.
+const boltOpenTimeout = 2 * time.Second
+
+// NewBoltStore opens (creating if needed) the BoltDB file at path. Only one
+// process may hold it open at a time; a concurrent open past boltOpenTimeout
+// returns an error rather than blocking forever.
+func NewBoltStore(path string) (*BoltStore, error) {
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: boltOpenTimeout})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open bolt db: %w", err)
+	}
+
+	err = db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(tasksBucket)
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to create tasks bucket: %w", err)
+	}
+
+	return &BoltStore{db: db}, nil
+}
+
+func (s *BoltStore) Add(description string) (Task, error) {
+	var task Task
+
+	err := s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(tasksBucket)
+
+		id, err := b.NextSequence()
+		if err != nil {
+			return err
+		}
+
+		task = Task{
+			ID:          int(id),
+			Description: description,
+			CreatedAt:   time.Now(),
+			IsCompleted: false,
+		}
+
+		return putTask(b, task)
+	})
+	if err != nil {
+		return Task{}, err
+	}
+
+	return task, nil
+}
+
+func (s *BoltStore) Get(id int) (Task, error) {
+	var task Task
+
+	err := s.db.View(func(tx *bolt.Tx) error {
+		t, err := getTask(tx.Bucket(tasksBucket), id)
+		if err != nil {
+			return err
+		}
+		task = t
+		return nil
+	})
+
+	return task, err
+}
+
+func (s *BoltStore) List(filter Filter) ([]Task, error) {
+	var tasks []Task
+
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(tasksBucket).ForEach(func(_, v []byte) error {
+			var task Task
+			if err := json.Unmarshal(v, &task); err != nil {
+				return err
+			}
+			if filter.IncludeCompleted || !task.IsCompleted {
+				tasks = append(tasks, task)
+			}
+			return nil
+		})
+	})
+
+	return tasks, err
+}
+
+func (s *BoltStore) Complete(id int) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(tasksBucket)
+
+		task, err := getTask(b, id)
+		if err != nil {
+			return err
+		}
+
+		task.IsCompleted = true
+		return putTask(b, task)
+	})
+}
+
+func (s *BoltStore) Delete(id int) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		b := tx.Bucket(tasksBucket)
+
+		if _, err := getTask(b, id); err != nil {
+			return err
+		}
+
+		return b.Delete(idKey(id))
+	})
+}
+
+func (s *BoltStore) Close() error {
+	return s.db.Close()
+}
+
+func getTask(b *bolt.Bucket, id int) (Task, error) {
+	v := b.Get(idKey(id))
+	if v == nil {
+		return Task{}, ErrNotFound
+	}
+
+	var task Task
+	if err := json.Unmarshal(v, &task); err != nil {
+		return Task{}, err
+	}
+	return task, nil
+}
+
+func putTask(b *bolt.Bucket, task Task) error {
+	v, err := json.Marshal(task)
+	if err != nil {
+		return err
+	}
+	return b.Put(idKey(task.ID), v)
+}
+
+func idKey(id int) []byte {
+	return []byte(fmt.Sprintf("%010d", id))
+}
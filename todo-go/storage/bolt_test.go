@@ -0,0 +1,16 @@
+package storage
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestBoltStoreConformance(t *testing.T) {
+	store, err := NewBoltStore(filepath.Join(t.TempDir(), "tasks.db"))
+	if err != nil {
+		t.Fatalf("NewBoltStore: %v", err)
+	}
+	defer store.Close()
+
+	runConformanceSuite(t, store)
+}
@@ -0,0 +1,95 @@
+package storage
+
+import "testing"
+
+// runConformanceSuite exercises the TaskStore contract against store,
+// regardless of which backend built it.
+func runConformanceSuite(t *testing.T, store TaskStore) {
+	t.Helper()
+
+	t.Run("add and get", func(t *testing.T) {
+		task, err := store.Add("write tests")
+		if err != nil {
+			t.Fatalf("Add: %v", err)
+		}
+		if task.Description != "write tests" {
+			t.Errorf("got description %q, want %q", task.Description, "write tests")
+		}
+
+		got, err := store.Get(task.ID)
+		if err != nil {
+			t.Fatalf("Get: %v", err)
+		}
+		if got.ID != task.ID || got.Description != task.Description {
+			t.Errorf("Get returned %+v, want %+v", got, task)
+		}
+	})
+
+	t.Run("get missing task", func(t *testing.T) {
+		if _, err := store.Get(999999); err != ErrNotFound {
+			t.Errorf("Get on missing task: got err %v, want ErrNotFound", err)
+		}
+	})
+
+	t.Run("list excludes completed by default", func(t *testing.T) {
+		task, err := store.Add("finish the report")
+		if err != nil {
+			t.Fatalf("Add: %v", err)
+		}
+		if err := store.Complete(task.ID); err != nil {
+			t.Fatalf("Complete: %v", err)
+		}
+
+		tasks, err := store.List(Filter{IncludeCompleted: false})
+		if err != nil {
+			t.Fatalf("List: %v", err)
+		}
+		for _, tk := range tasks {
+			if tk.ID == task.ID {
+				t.Errorf("List(IncludeCompleted: false) still contains completed task %d", task.ID)
+			}
+		}
+
+		tasks, err = store.List(Filter{IncludeCompleted: true})
+		if err != nil {
+			t.Fatalf("List: %v", err)
+		}
+		found := false
+		for _, tk := range tasks {
+			if tk.ID == task.ID {
+				found = true
+				if !tk.IsCompleted {
+					t.Errorf("expected task %d to be completed", task.ID)
+				}
+			}
+		}
+		if !found {
+			t.Errorf("List(IncludeCompleted: true) is missing completed task %d", task.ID)
+		}
+	})
+
+	t.Run("complete missing task", func(t *testing.T) {
+		if err := store.Complete(999999); err != ErrNotFound {
+			t.Errorf("Complete on missing task: got err %v, want ErrNotFound", err)
+		}
+	})
+
+	t.Run("delete removes task", func(t *testing.T) {
+		task, err := store.Add("temporary")
+		if err != nil {
+			t.Fatalf("Add: %v", err)
+		}
+		if err := store.Delete(task.ID); err != nil {
+			t.Fatalf("Delete: %v", err)
+		}
+		if _, err := store.Get(task.ID); err != ErrNotFound {
+			t.Errorf("Get after Delete: got err %v, want ErrNotFound", err)
+		}
+	})
+
+	t.Run("delete missing task", func(t *testing.T) {
+		if err := store.Delete(999999); err != ErrNotFound {
+			t.Errorf("Delete on missing task: got err %v, want ErrNotFound", err)
+		}
+	})
+}
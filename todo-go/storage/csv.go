@@ -0,0 +1,228 @@
+package storage
+
+import (
+	"encoding/csv"
+	"fmt"
+	"os"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// CSVStore is the original flatfile backend: every mutating call rewrites
+// the whole file under an flock. It has no Windows support and no
+// concurrent-edit safety, but is kept for backwards compatibility and as
+// the default migration source.
+type CSVStore struct {
+	path string
+}
+
+// NewCSVStore opens (creating if needed) the CSV file at path.
+func NewCSVStore(path string) (*CSVStore, error) {
+	f, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, os.ModePerm)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file for reading")
+	}
+	f.Close()
+
+	return &CSVStore{path: path}, nil
+}
+
+func (s *CSVStore) lock() (*os.File, error) {
+	f, err := os.OpenFile(s.path, os.O_RDWR|os.O_CREATE, os.ModePerm)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file for reading")
+	}
+
+	if err := syscall.Flock(int(f.Fd()), syscall.LOCK_EX); err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+
+	return f, nil
+}
+
+func (s *CSVStore) unlock(f *os.File) error {
+	syscall.Flock(int(f.Fd()), syscall.LOCK_UN)
+	return f.Close()
+}
+
+func (s *CSVStore) loadAll() ([]Task, error) {
+	f, err := s.lock()
+	if err != nil {
+		return nil, err
+	}
+	defer s.unlock(f)
+
+	reader := csv.NewReader(f)
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CSV: %w", err)
+	}
+
+	var tasks []Task
+	for i, record := range records {
+		if i == 0 {
+			continue // Skip headers
+		}
+		id, _ := strconv.Atoi(record[0])
+		createdAt, _ := time.Parse(time.RFC3339, record[2])
+		isComplete, _ := strconv.ParseBool(record[3])
+
+		tasks = append(tasks, Task{
+			ID:          id,
+			Description: record[1],
+			CreatedAt:   createdAt,
+			IsCompleted: isComplete,
+		})
+	}
+
+	return tasks, nil
+}
+
+func (s *CSVStore) saveAll(tasks []Task) error {
+	f, err := s.lock()
+	if err != nil {
+		return err
+	}
+	defer s.unlock(f)
+
+	if err := f.Truncate(0); err != nil {
+		return fmt.Errorf("failed to truncate CSV: %w", err)
+	}
+	if _, err := f.Seek(0, 0); err != nil {
+		return fmt.Errorf("failed to rewind CSV: %w", err)
+	}
+
+	writer := csv.NewWriter(f)
+	defer writer.Flush()
+
+	if err := writer.Write([]string{"ID", "Description", "CreatedAt", "IsCompleted"}); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+
+	for _, task := range tasks {
+		err := writer.Write([]string{
+			strconv.Itoa(task.ID),
+			task.Description,
+			task.CreatedAt.Format(time.RFC3339),
+			strconv.FormatBool(task.IsCompleted),
+		})
+		if err != nil {
+			return fmt.Errorf("failed to write task to CSV: %w", err)
+		}
+	}
+	return nil
+}
+
+func nextID(tasks []Task) int {
+	maxID := 0
+	for _, task := range tasks {
+		if task.ID > maxID {
+			maxID = task.ID
+		}
+	}
+	return maxID + 1
+}
+
+func (s *CSVStore) Add(description string) (Task, error) {
+	tasks, err := s.loadAll()
+	if err != nil {
+		return Task{}, err
+	}
+
+	task := Task{
+		ID:          nextID(tasks),
+		Description: description,
+		CreatedAt:   time.Now(),
+		IsCompleted: false,
+	}
+
+	tasks = append(tasks, task)
+	if err := s.saveAll(tasks); err != nil {
+		return Task{}, err
+	}
+
+	return task, nil
+}
+
+func (s *CSVStore) Get(id int) (Task, error) {
+	tasks, err := s.loadAll()
+	if err != nil {
+		return Task{}, err
+	}
+
+	for _, task := range tasks {
+		if task.ID == id {
+			return task, nil
+		}
+	}
+
+	return Task{}, ErrNotFound
+}
+
+func (s *CSVStore) List(filter Filter) ([]Task, error) {
+	tasks, err := s.loadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	if filter.IncludeCompleted {
+		return tasks, nil
+	}
+
+	filtered := make([]Task, 0, len(tasks))
+	for _, task := range tasks {
+		if !task.IsCompleted {
+			filtered = append(filtered, task)
+		}
+	}
+	return filtered, nil
+}
+
+func (s *CSVStore) Complete(id int) error {
+	tasks, err := s.loadAll()
+	if err != nil {
+		return err
+	}
+
+	found := false
+	for i := range tasks {
+		if tasks[i].ID == id {
+			tasks[i].IsCompleted = true
+			found = true
+			break
+		}
+	}
+	if !found {
+		return ErrNotFound
+	}
+
+	return s.saveAll(tasks)
+}
+
+func (s *CSVStore) Delete(id int) error {
+	tasks, err := s.loadAll()
+	if err != nil {
+		return err
+	}
+
+	found := false
+	newTasks := make([]Task, 0, len(tasks))
+	for _, task := range tasks {
+		if task.ID == id {
+			found = true
+			continue
+		}
+		newTasks = append(newTasks, task)
+	}
+	if !found {
+		return ErrNotFound
+	}
+
+	return s.saveAll(newTasks)
+}
+
+func (s *CSVStore) Close() error {
+	return nil
+}
@@ -0,0 +1,16 @@
+package storage
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestCSVStoreConformance(t *testing.T) {
+	store, err := NewCSVStore(filepath.Join(t.TempDir(), "tasks.csv"))
+	if err != nil {
+		t.Fatalf("NewCSVStore: %v", err)
+	}
+	defer store.Close()
+
+	runConformanceSuite(t, store)
+}
@@ -0,0 +1,29 @@
+package storage
+
+import "fmt"
+
+// Migrate copies every task from one backend to another. Each task is
+// re-added through the destination's Add, so its ID is reassigned by
+// whatever the destination backend uses to generate IDs (bucket sequence,
+// autoincrement, etc.) rather than preserved from the source.
+func Migrate(from, to TaskStore) error {
+	tasks, err := from.List(Filter{IncludeCompleted: true})
+	if err != nil {
+		return fmt.Errorf("failed to read source tasks: %w", err)
+	}
+
+	for _, task := range tasks {
+		added, err := to.Add(task.Description)
+		if err != nil {
+			return fmt.Errorf("failed to migrate task %d: %w", task.ID, err)
+		}
+
+		if task.IsCompleted {
+			if err := to.Complete(added.ID); err != nil {
+				return fmt.Errorf("failed to mark migrated task %d complete: %w", added.ID, err)
+			}
+		}
+	}
+
+	return nil
+}
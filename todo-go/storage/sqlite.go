@@ -0,0 +1,148 @@
+package storage
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+// SQLiteStore persists tasks in a single-table SQLite database, relying on
+// SQLite itself for ID assignment and transactional safety.
+type SQLiteStore struct {
+	db *sql.DB
+}
+
+// NewSQLiteStore opens (creating if needed) the SQLite database at path.
+func NewSQLiteStore(path string) (*SQLiteStore, error) {
+	db, err := sql.Open("sqlite3", path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open sqlite db: %w", err)
+	}
+
+	const schema = `
+	CREATE TABLE IF NOT EXISTS tasks (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		description TEXT NOT NULL,
+		created_at TEXT NOT NULL,
+		is_completed BOOLEAN NOT NULL DEFAULT 0
+	);`
+
+	if _, err := db.Exec(schema); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to create tasks table: %w", err)
+	}
+
+	return &SQLiteStore{db: db}, nil
+}
+
+func (s *SQLiteStore) Add(description string) (Task, error) {
+	task := Task{
+		Description: description,
+		CreatedAt:   time.Now(),
+		IsCompleted: false,
+	}
+
+	res, err := s.db.Exec(
+		`INSERT INTO tasks (description, created_at, is_completed) VALUES (?, ?, ?)`,
+		task.Description, task.CreatedAt.Format(time.RFC3339), task.IsCompleted,
+	)
+	if err != nil {
+		return Task{}, fmt.Errorf("failed to insert task: %w", err)
+	}
+
+	id, err := res.LastInsertId()
+	if err != nil {
+		return Task{}, fmt.Errorf("failed to read new task id: %w", err)
+	}
+	task.ID = int(id)
+
+	return task, nil
+}
+
+func (s *SQLiteStore) Get(id int) (Task, error) {
+	row := s.db.QueryRow(
+		`SELECT id, description, created_at, is_completed FROM tasks WHERE id = ?`, id,
+	)
+	return scanTask(row)
+}
+
+func (s *SQLiteStore) List(filter Filter) ([]Task, error) {
+	query := `SELECT id, description, created_at, is_completed FROM tasks`
+	if !filter.IncludeCompleted {
+		query += ` WHERE is_completed = 0`
+	}
+
+	rows, err := s.db.Query(query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tasks: %w", err)
+	}
+	defer rows.Close()
+
+	var tasks []Task
+	for rows.Next() {
+		task, err := scanTask(rows)
+		if err != nil {
+			return nil, err
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, rows.Err()
+}
+
+func (s *SQLiteStore) Complete(id int) error {
+	res, err := s.db.Exec(`UPDATE tasks SET is_completed = 1 WHERE id = ?`, id)
+	if err != nil {
+		return fmt.Errorf("failed to complete task: %w", err)
+	}
+	return requireRowAffected(res)
+}
+
+func (s *SQLiteStore) Delete(id int) error {
+	res, err := s.db.Exec(`DELETE FROM tasks WHERE id = ?`, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete task: %w", err)
+	}
+	return requireRowAffected(res)
+}
+
+func (s *SQLiteStore) Close() error {
+	return s.db.Close()
+}
+
+// rowScanner is satisfied by both *sql.Row and *sql.Rows.
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanTask(row rowScanner) (Task, error) {
+	var task Task
+	var createdAt string
+
+	if err := row.Scan(&task.ID, &task.Description, &createdAt, &task.IsCompleted); err != nil {
+		if err == sql.ErrNoRows {
+			return Task{}, ErrNotFound
+		}
+		return Task{}, fmt.Errorf("failed to scan task: %w", err)
+	}
+
+	parsed, err := time.Parse(time.RFC3339, createdAt)
+	if err != nil {
+		return Task{}, fmt.Errorf("failed to parse created_at: %w", err)
+	}
+	task.CreatedAt = parsed
+
+	return task, nil
+}
+
+func requireRowAffected(res sql.Result) error {
+	n, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to read rows affected: %w", err)
+	}
+	if n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
@@ -0,0 +1,16 @@
+package storage
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestSQLiteStoreConformance(t *testing.T) {
+	store, err := NewSQLiteStore(filepath.Join(t.TempDir(), "tasks.db"))
+	if err != nil {
+		t.Fatalf("NewSQLiteStore: %v", err)
+	}
+	defer store.Close()
+
+	runConformanceSuite(t, store)
+}
@@ -0,0 +1,36 @@
+// Package storage defines the TaskStore backends used by the tasks CLI.
+package storage
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrNotFound is returned by Get, Complete and Delete when no task matches
+// the given ID.
+var ErrNotFound = errors.New("task not found")
+
+// Task is a single to-do item, independent of how it is persisted.
+type Task struct {
+	ID          int
+	Description string
+	CreatedAt   time.Time
+	IsCompleted bool
+}
+
+// Filter narrows down which tasks List returns.
+type Filter struct {
+	// IncludeCompleted also returns tasks that are already done.
+	IncludeCompleted bool
+}
+
+// TaskStore is the persistence backend behind the tasks CLI. Implementations
+// must be safe for concurrent use by multiple CLI invocations.
+type TaskStore interface {
+	Add(description string) (Task, error)
+	Get(id int) (Task, error)
+	List(filter Filter) ([]Task, error)
+	Complete(id int) error
+	Delete(id int) error
+	Close() error
+}